@@ -1,14 +1,32 @@
 package nanopdf
 
 import (
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
 	"errors"
+	"io"
+	"os"
 )
 
 // Buffer is a dynamic byte buffer for PDF data.
+//
+// Buffer implements io.Reader, io.Writer, io.WriterTo, and io.ReaderFrom.
+// Writes always append to the end of the buffer; reads consume data from an
+// internal cursor that starts at the beginning and is rewound by Reset.
 type Buffer struct {
 	ptr uintptr
+	pos int
 }
 
+var (
+	_ io.Reader     = (*Buffer)(nil)
+	_ io.Writer     = (*Buffer)(nil)
+	_ io.WriterTo   = (*Buffer)(nil)
+	_ io.ReaderFrom = (*Buffer)(nil)
+)
+
 // NewBuffer creates a new buffer with the given initial capacity.
 func NewBuffer(capacity int) *Buffer {
 	ptr := bufferNew(capacity)
@@ -35,6 +53,45 @@ func NewBufferFromString(s string) *Buffer {
 	return NewBufferFromBytes([]byte(s))
 }
 
+// NewBufferFromBase64 creates a buffer from standard base64-encoded data.
+func NewBufferFromBase64(s string) (*Buffer, error) {
+	data, err := base64.StdEncoding.DecodeString(s)
+	if err != nil {
+		return nil, WrapError(ErrCodeFormat, "invalid base64 data", err)
+	}
+	buf := NewBufferFromBytes(data)
+	if buf == nil {
+		return nil, ErrGeneric("failed to create buffer")
+	}
+	return buf, nil
+}
+
+// NewBufferFromHex creates a buffer from hex-encoded data.
+func NewBufferFromHex(s string) (*Buffer, error) {
+	data, err := hex.DecodeString(s)
+	if err != nil {
+		return nil, WrapError(ErrCodeFormat, "invalid hex data", err)
+	}
+	buf := NewBufferFromBytes(data)
+	if buf == nil {
+		return nil, ErrGeneric("failed to create buffer")
+	}
+	return buf, nil
+}
+
+// NewBufferFromFile creates a buffer holding the contents of the file at path.
+func NewBufferFromFile(path string) (*Buffer, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, ErrSystem("failed to read file", err)
+	}
+	buf := NewBufferFromBytes(data)
+	if buf == nil {
+		return nil, ErrGeneric("failed to create buffer")
+	}
+	return buf, nil
+}
+
 // Free releases the buffer's resources.
 // The buffer should not be used after calling Free.
 func (b *Buffer) Free() {
@@ -52,6 +109,32 @@ func (b *Buffer) Len() int {
 	return bufferLen(b.ptr)
 }
 
+// Cap returns the number of bytes the buffer can hold without reallocating.
+// Native buffers do not expose their capacity, so cgo builds report Len.
+func (b *Buffer) Cap() int {
+	if b == nil || b.ptr == 0 {
+		return 0
+	}
+	return bufferCap(b.ptr)
+}
+
+// Grow ensures the buffer has room for at least n more bytes without
+// reallocating. Growing ahead of a series of appends avoids repeated
+// reallocation as the buffer fills. A non-positive n is a no-op, as is
+// any n in cgo builds, where the native buffer manages its own capacity.
+func (b *Buffer) Grow(n int) error {
+	if b == nil || b.ptr == 0 {
+		return errors.New("buffer is nil")
+	}
+	if n <= 0 {
+		return nil
+	}
+	if bufferGrow(b.ptr, n) != 0 {
+		return errors.New("failed to grow buffer")
+	}
+	return nil
+}
+
 // IsEmpty returns true if the buffer has no data.
 func (b *Buffer) IsEmpty() bool {
 	return b.Len() == 0
@@ -70,6 +153,26 @@ func (b *Buffer) String() string {
 	return string(b.Bytes())
 }
 
+// Base64 returns the buffer's data encoded as standard base64.
+func (b *Buffer) Base64() string {
+	return base64.StdEncoding.EncodeToString(b.Bytes())
+}
+
+// Hex returns the buffer's data encoded as lowercase hex.
+func (b *Buffer) Hex() string {
+	return hex.EncodeToString(b.Bytes())
+}
+
+// MD5 returns the MD5 digest of the buffer's data.
+func (b *Buffer) MD5() [16]byte {
+	return md5.Sum(b.Bytes())
+}
+
+// SHA256 returns the SHA-256 digest of the buffer's data.
+func (b *Buffer) SHA256() [32]byte {
+	return sha256.Sum256(b.Bytes())
+}
+
 // Append appends data to the buffer.
 func (b *Buffer) Append(data []byte) error {
 	if b == nil || b.ptr == 0 {
@@ -95,10 +198,148 @@ func (b *Buffer) AppendByte(c byte) error {
 	return b.Append([]byte{c})
 }
 
-// Clear removes all data from the buffer.
+// Clear removes all data from the buffer and rewinds the read cursor.
 func (b *Buffer) Clear() {
 	if b != nil && b.ptr != 0 {
 		bufferClear(b.ptr)
+		b.pos = 0
+	}
+}
+
+// Reset rewinds the read cursor to the beginning of the buffer.
+// The buffer's data is left unchanged.
+func (b *Buffer) Reset() {
+	if b != nil {
+		b.pos = 0
+	}
+}
+
+// Write appends p to the buffer. It implements io.Writer.
+func (b *Buffer) Write(p []byte) (int, error) {
+	if err := b.Append(p); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// Read reads up to len(p) bytes from the read cursor and advances it.
+// It returns io.EOF once the cursor reaches the end of the buffer.
+// It implements io.Reader.
+func (b *Buffer) Read(p []byte) (int, error) {
+	if b == nil || b.ptr == 0 {
+		return 0, errors.New("buffer is nil")
+	}
+	if len(p) == 0 {
+		return 0, nil
+	}
+	data := bufferDataRange(b.ptr, b.pos, len(p))
+	if len(data) == 0 {
+		return 0, io.EOF
+	}
+	n := copy(p, data)
+	b.pos += n
+	return n, nil
+}
+
+// WriteTo writes the unread data to w and advances the read cursor.
+// It implements io.WriterTo.
+func (b *Buffer) WriteTo(w io.Writer) (int64, error) {
+	if b == nil || b.ptr == 0 {
+		return 0, errors.New("buffer is nil")
+	}
+	var total int64
+	for {
+		chunk := bufferDataRange(b.ptr, b.pos, 32*1024)
+		if len(chunk) == 0 {
+			return total, nil
+		}
+		n, err := w.Write(chunk)
+		b.pos += n
+		total += int64(n)
+		if err == nil && n < len(chunk) {
+			err = io.ErrShortWrite
+		}
+		if err != nil {
+			return total, err
+		}
+	}
+}
+
+// ReadFrom appends data read from r until EOF.
+// It implements io.ReaderFrom.
+func (b *Buffer) ReadFrom(r io.Reader) (int64, error) {
+	if b == nil || b.ptr == 0 {
+		return 0, errors.New("buffer is nil")
+	}
+	var total int64
+	chunk := make([]byte, 32*1024)
+	for {
+		n, err := r.Read(chunk)
+		if n > 0 {
+			if appendErr := b.Append(chunk[:n]); appendErr != nil {
+				return total, appendErr
+			}
+			total += int64(n)
+		}
+		if err == io.EOF {
+			return total, nil
+		}
+		if err != nil {
+			return total, err
+		}
+	}
+}
+
+// SaveToFile writes the buffer's data to the file at path, creating or
+// truncating it with the given permissions.
+func (b *Buffer) SaveToFile(path string, perm os.FileMode) error {
+	if b == nil || b.ptr == 0 {
+		return errors.New("buffer is nil")
+	}
+	if err := os.WriteFile(path, b.Bytes(), perm); err != nil {
+		return ErrSystem("failed to write file", err)
+	}
+	return nil
+}
+
+// Slice returns a new buffer containing a copy of the bytes in [start, end).
+func (b *Buffer) Slice(start, end int) (*Buffer, error) {
+	if b == nil || b.ptr == 0 {
+		return nil, errors.New("buffer is nil")
+	}
+	data := b.Bytes()
+	if start < 0 || end < start || end > len(data) {
+		return nil, ErrOutOfBounds
+	}
+	buf := NewBufferFromBytes(data[start:end])
+	if buf == nil {
+		return nil, ErrGeneric("failed to create buffer")
+	}
+	return buf, nil
+}
+
+// Truncate discards all but the first n bytes of the buffer.
+// Values of n greater than or equal to Len leave the buffer unchanged;
+// negative values are treated as 0.
+func (b *Buffer) Truncate(n int) {
+	if b == nil || b.ptr == 0 {
+		return
+	}
+	data := b.Bytes()
+	if n >= len(data) {
+		return
+	}
+	if n < 0 {
+		n = 0
+	}
+	ptr := bufferFromData(data[:n])
+	if ptr == 0 {
+		return
+	}
+	bufferFree(b.ptr)
+	b.ptr = ptr
+	if b.pos > n {
+		b.pos = n
 	}
 }
 
@@ -0,0 +1,58 @@
+//go:build cgo && !mock
+// +build cgo,!mock
+
+package nanopdf
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestBufferNativeReadback(t *testing.T) {
+	buf := NewBuffer(0)
+	if buf == nil {
+		t.Fatal("expected non-nil buffer")
+	}
+	defer buf.Free()
+
+	want := []byte("%PDF-1.7 native readback")
+	if err := buf.Append(want); err != nil {
+		t.Fatalf("append failed: %v", err)
+	}
+
+	got := buf.Bytes()
+	if !bytes.Equal(got, want) {
+		t.Errorf("expected %q, got %q", want, got)
+	}
+	if buf.String() != string(want) {
+		t.Errorf("expected %q, got %q", want, buf.String())
+	}
+}
+
+func TestBufferNativeGrow(t *testing.T) {
+	buf := NewBufferFromString("abc")
+	if buf == nil {
+		t.Fatal("expected non-nil buffer")
+	}
+	defer buf.Free()
+
+	if err := buf.Grow(64 * 1024); err != nil {
+		t.Fatalf("grow failed: %v", err)
+	}
+	if buf.Cap() < buf.Len() {
+		t.Errorf("expected capacity of at least %d, got %d", buf.Len(), buf.Cap())
+	}
+
+	chunk := bytes.Repeat([]byte("x"), 4096)
+	for i := 0; i < 16; i++ {
+		if err := buf.Append(chunk); err != nil {
+			t.Fatalf("append after grow failed: %v", err)
+		}
+	}
+	if buf.Len() != 3+16*len(chunk) {
+		t.Errorf("expected %d bytes, got %d", 3+16*len(chunk), buf.Len())
+	}
+	if !bytes.HasPrefix(buf.Bytes(), []byte("abc")) {
+		t.Error("grow should preserve existing data")
+	}
+}
@@ -0,0 +1,62 @@
+//go:build !cgo || mock
+// +build !cgo mock
+
+package nanopdf
+
+import "testing"
+
+func TestBufferGrowCap(t *testing.T) {
+	t.Run("Cap", func(t *testing.T) {
+		buf := NewBufferFromString("abc")
+		defer buf.Free()
+
+		if err := buf.Grow(100); err != nil {
+			t.Fatalf("grow failed: %v", err)
+		}
+		if buf.Cap() < buf.Len()+100 {
+			t.Errorf("expected capacity of at least %d, got %d", buf.Len()+100, buf.Cap())
+		}
+	})
+
+	t.Run("NonPositive", func(t *testing.T) {
+		buf := NewBuffer(8)
+		defer buf.Free()
+
+		before := buf.Cap()
+		if err := buf.Grow(0); err != nil {
+			t.Errorf("grow(0) failed: %v", err)
+		}
+		if err := buf.Grow(-1); err != nil {
+			t.Errorf("grow(-1) failed: %v", err)
+		}
+		if buf.Cap() != before {
+			t.Errorf("expected capacity %d, got %d", before, buf.Cap())
+		}
+	})
+}
+
+func TestBufferGrowAllocations(t *testing.T) {
+	chunk := make([]byte, 4096)
+	const chunks = 64
+
+	fill := func(grow bool) {
+		buf := NewBuffer(0)
+		defer buf.Free()
+		if grow {
+			if err := buf.Grow(len(chunk) * chunks); err != nil {
+				t.Fatal(err)
+			}
+		}
+		for i := 0; i < chunks; i++ {
+			if err := buf.Append(chunk); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+
+	without := testing.AllocsPerRun(10, func() { fill(false) })
+	with := testing.AllocsPerRun(10, func() { fill(true) })
+	if with >= without {
+		t.Errorf("expected fewer allocations with Grow, got %v with and %v without", with, without)
+	}
+}
@@ -2,6 +2,11 @@ package nanopdf
 
 import (
 	"bytes"
+	"encoding/hex"
+	"errors"
+	"io"
+	"path/filepath"
+	"strings"
 	"testing"
 )
 
@@ -132,3 +137,415 @@ func TestBuffer(t *testing.T) {
 	})
 }
 
+func TestBufferIO(t *testing.T) {
+	t.Run("CopyInto", func(t *testing.T) {
+		buf := NewBuffer(0)
+		defer buf.Free()
+
+		n, err := io.Copy(buf, strings.NewReader("Hello, World!"))
+		if err != nil {
+			t.Fatalf("copy failed: %v", err)
+		}
+		if n != 13 {
+			t.Errorf("expected 13 bytes copied, got %d", n)
+		}
+		if buf.String() != "Hello, World!" {
+			t.Errorf("expected %q, got %q", "Hello, World!", buf.String())
+		}
+	})
+
+	t.Run("CopyOut", func(t *testing.T) {
+		buf := NewBufferFromString("Hello, World!")
+		defer buf.Free()
+
+		var out bytes.Buffer
+		n, err := io.Copy(&out, buf)
+		if err != nil {
+			t.Fatalf("copy failed: %v", err)
+		}
+		if n != 13 {
+			t.Errorf("expected 13 bytes copied, got %d", n)
+		}
+		if out.String() != "Hello, World!" {
+			t.Errorf("expected %q, got %q", "Hello, World!", out.String())
+		}
+	})
+
+	t.Run("Write", func(t *testing.T) {
+		buf := NewBuffer(0)
+		defer buf.Free()
+
+		n, err := buf.Write([]byte("abc"))
+		if err != nil {
+			t.Fatalf("write failed: %v", err)
+		}
+		if n != 3 {
+			t.Errorf("expected 3 bytes written, got %d", n)
+		}
+		if buf.String() != "abc" {
+			t.Errorf("expected %q, got %q", "abc", buf.String())
+		}
+	})
+
+	t.Run("ReadCursor", func(t *testing.T) {
+		buf := NewBufferFromString("abcdef")
+		defer buf.Free()
+
+		p := make([]byte, 4)
+		n, err := buf.Read(p)
+		if err != nil || n != 4 || string(p[:n]) != "abcd" {
+			t.Fatalf("expected %q, got %q (err %v)", "abcd", p[:n], err)
+		}
+		n, err = buf.Read(p)
+		if err != nil || n != 2 || string(p[:n]) != "ef" {
+			t.Fatalf("expected %q, got %q (err %v)", "ef", p[:n], err)
+		}
+		if _, err = buf.Read(p); err != io.EOF {
+			t.Errorf("expected io.EOF, got %v", err)
+		}
+
+		buf.Reset()
+		data, err := io.ReadAll(buf)
+		if err != nil {
+			t.Fatalf("read after reset failed: %v", err)
+		}
+		if string(data) != "abcdef" {
+			t.Errorf("expected %q after reset, got %q", "abcdef", data)
+		}
+	})
+
+	t.Run("ReadByteAtATime", func(t *testing.T) {
+		want := bytes.Repeat([]byte("0123456789abcdef"), 5000)
+		buf := NewBufferFromBytes(want)
+		defer buf.Free()
+
+		got := make([]byte, 0, len(want))
+		p := make([]byte, 1)
+		for {
+			n, err := buf.Read(p)
+			got = append(got, p[:n]...)
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				t.Fatalf("read failed at %d: %v", len(got), err)
+			}
+			if n != 1 {
+				t.Fatalf("expected 1 byte at %d, got %d", len(got), n)
+			}
+		}
+		if !bytes.Equal(got, want) {
+			t.Errorf("expected %d bytes to round-trip, got %d", len(want), len(got))
+		}
+	})
+
+	t.Run("WriteToMultiChunk", func(t *testing.T) {
+		want := bytes.Repeat([]byte("0123456789abcdef"), 5000)
+		buf := NewBufferFromBytes(want)
+		defer buf.Free()
+
+		p := make([]byte, 10)
+		if _, err := buf.Read(p); err != nil {
+			t.Fatalf("read failed: %v", err)
+		}
+		var out bytes.Buffer
+		n, err := buf.WriteTo(&out)
+		if err != nil {
+			t.Fatalf("write to failed: %v", err)
+		}
+		if n != int64(len(want)-10) || !bytes.Equal(out.Bytes(), want[10:]) {
+			t.Errorf("expected %d unread bytes, got %d", len(want)-10, n)
+		}
+		if n, _ := buf.WriteTo(&out); n != 0 {
+			t.Errorf("expected nothing left to write, got %d", n)
+		}
+	})
+
+	t.Run("NilBuffer", func(t *testing.T) {
+		var buf *Buffer
+		if _, err := buf.Read(make([]byte, 1)); err == nil {
+			t.Error("expected error reading nil buffer")
+		}
+		if _, err := buf.Write([]byte("x")); err == nil {
+			t.Error("expected error writing nil buffer")
+		}
+	})
+}
+
+func TestBufferFile(t *testing.T) {
+	t.Run("RoundTrip", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "out.pdf")
+
+		buf := NewBufferFromString("%PDF-1.4\n")
+		defer buf.Free()
+		if err := buf.SaveToFile(path, 0o644); err != nil {
+			t.Fatalf("save failed: %v", err)
+		}
+
+		loaded, err := NewBufferFromFile(path)
+		if err != nil {
+			t.Fatalf("load failed: %v", err)
+		}
+		defer loaded.Free()
+
+		if !bytes.Equal(loaded.Bytes(), buf.Bytes()) {
+			t.Errorf("expected %q, got %q", buf.String(), loaded.String())
+		}
+	})
+
+	t.Run("EmptyFile", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "empty")
+
+		buf := NewBuffer(0)
+		defer buf.Free()
+		if err := buf.SaveToFile(path, 0o644); err != nil {
+			t.Fatalf("save failed: %v", err)
+		}
+
+		loaded, err := NewBufferFromFile(path)
+		if err != nil {
+			t.Fatalf("load failed: %v", err)
+		}
+		defer loaded.Free()
+
+		if !loaded.IsEmpty() {
+			t.Errorf("expected empty buffer, got %d bytes", loaded.Len())
+		}
+	})
+
+	t.Run("MissingFile", func(t *testing.T) {
+		_, err := NewBufferFromFile(filepath.Join(t.TempDir(), "missing.pdf"))
+		if err == nil {
+			t.Fatal("expected error for missing file")
+		}
+		if !errors.Is(err, ErrSystem("", nil)) {
+			t.Errorf("expected system error, got %v", err)
+		}
+	})
+
+	t.Run("NilBuffer", func(t *testing.T) {
+		var buf *Buffer
+		if err := buf.SaveToFile(filepath.Join(t.TempDir(), "nil"), 0o644); err == nil {
+			t.Error("expected error saving nil buffer")
+		}
+	})
+}
+
+func TestBufferGrow(t *testing.T) {
+	t.Run("PreservesData", func(t *testing.T) {
+		buf := NewBufferFromString("abc")
+		defer buf.Free()
+
+		for _, n := range []int{100, 0, -1} {
+			if err := buf.Grow(n); err != nil {
+				t.Fatalf("grow(%d) failed: %v", n, err)
+			}
+		}
+		if buf.String() != "abc" {
+			t.Errorf("grow should preserve data, got %q", buf.String())
+		}
+		if buf.Cap() < buf.Len() {
+			t.Errorf("expected capacity of at least %d, got %d", buf.Len(), buf.Cap())
+		}
+	})
+
+	t.Run("NilBuffer", func(t *testing.T) {
+		var buf *Buffer
+		if err := buf.Grow(10); err == nil {
+			t.Error("expected error growing nil buffer")
+		}
+		if buf.Cap() != 0 {
+			t.Error("nil buffer should have capacity 0")
+		}
+	})
+}
+
+func TestBufferSlice(t *testing.T) {
+	t.Run("Slice", func(t *testing.T) {
+		buf := NewBufferFromString("Hello, World!")
+		defer buf.Free()
+
+		tests := []struct {
+			name       string
+			start, end int
+			want       string
+		}{
+			{"Middle", 7, 12, "World"},
+			{"Full", 0, 13, "Hello, World!"},
+			{"Empty", 5, 5, ""},
+		}
+		for _, tt := range tests {
+			sub, err := buf.Slice(tt.start, tt.end)
+			if err != nil {
+				t.Fatalf("%s: slice failed: %v", tt.name, err)
+			}
+			if sub.String() != tt.want {
+				t.Errorf("%s: expected %q, got %q", tt.name, tt.want, sub.String())
+			}
+			sub.Free()
+		}
+	})
+
+	t.Run("SliceIndependent", func(t *testing.T) {
+		buf := NewBufferFromString("abc")
+		defer buf.Free()
+
+		sub, err := buf.Slice(0, 3)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer sub.Free()
+
+		buf.AppendString("def")
+		if sub.String() != "abc" {
+			t.Errorf("slice should be independent, got %q", sub.String())
+		}
+	})
+
+	t.Run("SliceOutOfBounds", func(t *testing.T) {
+		buf := NewBufferFromString("abc")
+		defer buf.Free()
+
+		for _, r := range [][2]int{{-1, 2}, {0, 4}, {2, 1}} {
+			if _, err := buf.Slice(r[0], r[1]); !errors.Is(err, ErrOutOfBounds) {
+				t.Errorf("Slice(%d, %d): expected out of bounds error, got %v", r[0], r[1], err)
+			}
+		}
+	})
+
+	t.Run("Truncate", func(t *testing.T) {
+		buf := NewBufferFromString("Hello, World!")
+		defer buf.Free()
+
+		buf.Truncate(20)
+		if buf.String() != "Hello, World!" {
+			t.Errorf("truncate beyond length should be a no-op, got %q", buf.String())
+		}
+
+		buf.Truncate(5)
+		if buf.String() != "Hello" {
+			t.Errorf("expected %q, got %q", "Hello", buf.String())
+		}
+
+		if err := buf.AppendString("!"); err != nil {
+			t.Fatal(err)
+		}
+		if buf.String() != "Hello!" {
+			t.Errorf("expected %q, got %q", "Hello!", buf.String())
+		}
+
+		buf.Truncate(0)
+		if !buf.IsEmpty() {
+			t.Errorf("expected empty buffer, got %q", buf.String())
+		}
+	})
+}
+
+func TestBufferEncoding(t *testing.T) {
+	t.Run("Base64RoundTrip", func(t *testing.T) {
+		for _, s := range []string{"", "%PDF-1.7\n\x00\xff"} {
+			buf := NewBufferFromString(s)
+			encoded := buf.Base64()
+			buf.Free()
+
+			decoded, err := NewBufferFromBase64(encoded)
+			if err != nil {
+				t.Fatalf("decode %q failed: %v", encoded, err)
+			}
+			if decoded.String() != s {
+				t.Errorf("expected %q, got %q", s, decoded.String())
+			}
+			decoded.Free()
+		}
+	})
+
+	t.Run("Base64Value", func(t *testing.T) {
+		buf := NewBufferFromString("PDF")
+		defer buf.Free()
+		if buf.Base64() != "UERG" {
+			t.Errorf("expected %q, got %q", "UERG", buf.Base64())
+		}
+	})
+
+	t.Run("HexRoundTrip", func(t *testing.T) {
+		for _, s := range []string{"", "%PDF-1.7\n\x00\xff"} {
+			buf := NewBufferFromString(s)
+			encoded := buf.Hex()
+			buf.Free()
+
+			decoded, err := NewBufferFromHex(encoded)
+			if err != nil {
+				t.Fatalf("decode %q failed: %v", encoded, err)
+			}
+			if decoded.String() != s {
+				t.Errorf("expected %q, got %q", s, decoded.String())
+			}
+			decoded.Free()
+		}
+	})
+
+	t.Run("HexValue", func(t *testing.T) {
+		buf := NewBufferFromBytes([]byte{0x00, 0xab, 0xff})
+		defer buf.Free()
+		if buf.Hex() != "00abff" {
+			t.Errorf("expected %q, got %q", "00abff", buf.Hex())
+		}
+	})
+
+	t.Run("InvalidEncoding", func(t *testing.T) {
+		if _, err := NewBufferFromBase64("not base64!"); !errors.Is(err, ErrFormat("")) {
+			t.Errorf("expected format error, got %v", err)
+		}
+		if _, err := NewBufferFromHex("xyz"); !errors.Is(err, ErrFormat("")) {
+			t.Errorf("expected format error, got %v", err)
+		}
+	})
+
+	t.Run("NilBuffer", func(t *testing.T) {
+		var buf *Buffer
+		if buf.Base64() != "" || buf.Hex() != "" {
+			t.Error("nil buffer should encode to empty strings")
+		}
+	})
+}
+
+func TestBufferDigest(t *testing.T) {
+	t.Run("KnownValues", func(t *testing.T) {
+		buf := NewBufferFromString("abc")
+		defer buf.Free()
+
+		md5sum := buf.MD5()
+		if got := hex.EncodeToString(md5sum[:]); got != "900150983cd24fb0d6963f7d28e17f72" {
+			t.Errorf("unexpected MD5 %s", got)
+		}
+		sha := buf.SHA256()
+		if got := hex.EncodeToString(sha[:]); got != "ba7816bf8f01cfea414140de5dae2223b00361a396177a9cb410ff61f20015ad" {
+			t.Errorf("unexpected SHA-256 %s", got)
+		}
+	})
+
+	t.Run("SameAndDifferent", func(t *testing.T) {
+		a := NewBufferFromString("same content")
+		defer a.Free()
+		b := NewBufferFromString("same content")
+		defer b.Free()
+		c := NewBufferFromString("other content")
+		defer c.Free()
+
+		if a.SHA256() != b.SHA256() || a.MD5() != b.MD5() {
+			t.Error("identical buffers should have identical digests")
+		}
+		if a.SHA256() == c.SHA256() || a.MD5() == c.MD5() {
+			t.Error("different buffers should have different digests")
+		}
+	})
+
+	t.Run("NilBuffer", func(t *testing.T) {
+		var buf *Buffer
+		empty := NewBuffer(0)
+		defer empty.Free()
+		if buf.SHA256() != empty.SHA256() {
+			t.Error("nil buffer should hash like an empty buffer")
+		}
+	})
+}
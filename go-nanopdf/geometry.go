@@ -1,6 +1,9 @@
 package nanopdf
 
-import "math"
+import (
+	"fmt"
+	"math"
+)
 
 // Point represents a 2D point.
 type Point struct {
@@ -50,6 +53,46 @@ func (p Point) Equals(other Point) bool {
 	return p.X == other.X && p.Y == other.Y
 }
 
+// String returns the point formatted as "(x, y)".
+func (p Point) String() string {
+	return fmt.Sprintf("(%g, %g)", p.X, p.Y)
+}
+
+// Dot returns the dot product with another point treated as a vector.
+func (p Point) Dot(other Point) float32 {
+	return p.X*other.X + p.Y*other.Y
+}
+
+// Cross returns the z-component of the cross product with another point
+// treated as a vector. It is positive when other is counter-clockwise from p.
+func (p Point) Cross(other Point) float32 {
+	return p.X*other.Y - p.Y*other.X
+}
+
+// Length returns the distance from the origin.
+func (p Point) Length() float32 {
+	return p.Distance(Origin)
+}
+
+// Normalize returns the unit vector in the same direction.
+// The zero point is returned unchanged.
+func (p Point) Normalize() Point {
+	l := p.Length()
+	if l == 0 {
+		return Origin
+	}
+	return p.Scale(1 / l)
+}
+
+// Lerp linearly interpolates towards another point.
+// t=0 returns p and t=1 returns other.
+func (p Point) Lerp(other Point, t float32) Point {
+	return Point{
+		X: p.X + (other.X-p.X)*t,
+		Y: p.Y + (other.Y-p.Y)*t,
+	}
+}
+
 // Rect represents a rectangle defined by two corner points.
 type Rect struct {
 	X0, Y0, X1, Y1 float32
@@ -164,6 +207,61 @@ func (r Rect) Scale(sx, sy float32) Rect {
 	}
 }
 
+// String returns the rectangle formatted as "Rect(x0,y0 → x1,y1)".
+func (r Rect) String() string {
+	return fmt.Sprintf("Rect(%g,%g → %g,%g)", r.X0, r.Y0, r.X1, r.Y1)
+}
+
+// Area returns the area of the rectangle, or 0 if it is empty.
+func (r Rect) Area() float32 {
+	if r.IsEmpty() {
+		return 0
+	}
+	return r.Width() * r.Height()
+}
+
+// Center returns the center point of the rectangle.
+func (r Rect) Center() Point {
+	return Point{X: (r.X0 + r.X1) / 2, Y: (r.Y0 + r.Y1) / 2}
+}
+
+// Inset shrinks the rectangle by dx horizontally and dy vertically on each side.
+// Negative values expand the rectangle.
+func (r Rect) Inset(dx, dy float32) Rect {
+	return Rect{
+		X0: r.X0 + dx,
+		Y0: r.Y0 + dy,
+		X1: r.X1 - dx,
+		Y1: r.Y1 - dy,
+	}
+}
+
+// ContainsRect checks if another rectangle lies entirely inside this one.
+// An empty rectangle is contained by any rectangle.
+func (r Rect) ContainsRect(other Rect) bool {
+	if other.IsEmpty() {
+		return true
+	}
+	if r.IsEmpty() {
+		return false
+	}
+	return other.X0 >= r.X0 && other.Y0 >= r.Y0 && other.X1 <= r.X1 && other.Y1 <= r.Y1
+}
+
+// Normalize returns the rectangle with its corners ordered so that X0 <= X1 and Y0 <= Y1.
+// RectEmpty is returned unchanged rather than being flipped into an infinite rectangle.
+func (r Rect) Normalize() Rect {
+	if r == RectEmpty {
+		return r
+	}
+	return Rect{
+		X0: min32(r.X0, r.X1),
+		Y0: min32(r.Y0, r.Y1),
+		X1: max32(r.X0, r.X1),
+		Y1: max32(r.Y0, r.Y1),
+	}
+}
+
 // IRect represents an integer rectangle.
 type IRect struct {
 	X0, Y0, X1, Y1 int32
@@ -199,6 +297,54 @@ func (r IRect) IsEmpty() bool {
 	return r.X0 >= r.X1 || r.Y0 >= r.Y1
 }
 
+// String returns the integer rectangle formatted as "IRect(x0,y0 → x1,y1)".
+func (r IRect) String() string {
+	return fmt.Sprintf("IRect(%d,%d → %d,%d)", r.X0, r.Y0, r.X1, r.Y1)
+}
+
+// Contains checks if integer coordinates are inside the rectangle.
+func (r IRect) Contains(x, y int32) bool {
+	return x >= r.X0 && x < r.X1 && y >= r.Y0 && y < r.Y1
+}
+
+// Union returns the union of two integer rectangles.
+// Empty rectangles do not contribute to the result.
+func (r IRect) Union(other IRect) IRect {
+	if r.IsEmpty() {
+		return other
+	}
+	if other.IsEmpty() {
+		return r
+	}
+	return IRect{
+		X0: min(r.X0, other.X0),
+		Y0: min(r.Y0, other.Y0),
+		X1: max(r.X1, other.X1),
+		Y1: max(r.Y1, other.Y1),
+	}
+}
+
+// Intersect returns the intersection of two integer rectangles.
+// The result is empty if they do not overlap.
+func (r IRect) Intersect(other IRect) IRect {
+	return IRect{
+		X0: max(r.X0, other.X0),
+		Y0: max(r.Y0, other.Y0),
+		X1: min(r.X1, other.X1),
+		Y1: min(r.Y1, other.Y1),
+	}
+}
+
+// Translate moves the integer rectangle by an offset.
+func (r IRect) Translate(dx, dy int32) IRect {
+	return IRect{
+		X0: r.X0 + dx,
+		Y0: r.Y0 + dy,
+		X1: r.X1 + dx,
+		Y1: r.Y1 + dy,
+	}
+}
+
 // Matrix represents a 2D transformation matrix.
 // The matrix is represented as:
 //
@@ -240,6 +386,33 @@ func MatrixShear(sx, sy float32) Matrix {
 	return Matrix{A: 1, B: sy, C: sx, D: 1, E: 0, F: 0}
 }
 
+// MatrixFit creates a matrix that scales src uniformly to fit within dst,
+// preserving aspect ratio and centering the result.
+// Returns Identity if either rectangle is empty or infinite.
+func MatrixFit(src, dst Rect) Matrix {
+	if src.IsEmpty() || dst.IsEmpty() || src.IsInfinite() || dst.IsInfinite() {
+		return Identity
+	}
+	s := min32(dst.Width()/src.Width(), dst.Height()/src.Height())
+	tx := dst.X0 + (dst.Width()-src.Width()*s)/2
+	ty := dst.Y0 + (dst.Height()-src.Height()*s)/2
+	return MatrixTranslate(-src.X0, -src.Y0).
+		Concat(MatrixScale(s, s)).
+		Concat(MatrixTranslate(tx, ty))
+}
+
+// MatrixFitStretch creates a matrix that maps src exactly onto dst,
+// ignoring aspect ratio.
+// Returns Identity if either rectangle is empty or infinite.
+func MatrixFitStretch(src, dst Rect) Matrix {
+	if src.IsEmpty() || dst.IsEmpty() || src.IsInfinite() || dst.IsInfinite() {
+		return Identity
+	}
+	return MatrixTranslate(-src.X0, -src.Y0).
+		Concat(MatrixScale(dst.Width()/src.Width(), dst.Height()/src.Height())).
+		Concat(MatrixTranslate(dst.X0, dst.Y0))
+}
+
 // Concat concatenates two matrices.
 func (m Matrix) Concat(other Matrix) Matrix {
 	return Matrix{
@@ -303,6 +476,64 @@ func (m Matrix) TransformRect(r Rect) Rect {
 	}
 }
 
+// String returns the matrix formatted as "Matrix[a b c d e f]".
+func (m Matrix) String() string {
+	return fmt.Sprintf("Matrix[%g %g %g %g %g %g]", m.A, m.B, m.C, m.D, m.E, m.F)
+}
+
+// Determinant returns the determinant of the matrix.
+func (m Matrix) Determinant() float32 {
+	return m.A*m.D - m.B*m.C
+}
+
+// Invert returns the inverse of the matrix.
+// The second return value is false if the matrix is not invertible.
+func (m Matrix) Invert() (Matrix, bool) {
+	det := m.Determinant()
+	if det == 0 {
+		return Matrix{}, false
+	}
+	a := m.D / det
+	b := -m.B / det
+	c := -m.C / det
+	d := m.A / det
+	return Matrix{
+		A: a,
+		B: b,
+		C: c,
+		D: d,
+		E: -m.E*a - m.F*c,
+		F: -m.E*b - m.F*d,
+	}, true
+}
+
+// IsIdentity returns true if the matrix is the identity matrix
+// within a small tolerance.
+func (m Matrix) IsIdentity() bool {
+	return approxEqual32(m.A, Identity.A) &&
+		approxEqual32(m.B, Identity.B) &&
+		approxEqual32(m.C, Identity.C) &&
+		approxEqual32(m.D, Identity.D) &&
+		approxEqual32(m.E, Identity.E) &&
+		approxEqual32(m.F, Identity.F)
+}
+
+// Decompose splits the matrix into scale, rotation (degrees), and translation
+// components, such that scale followed by rotation followed by translation
+// reproduces the matrix when it contains no shear.
+func (m Matrix) Decompose() (scaleX, scaleY, rotationDegrees, translateX, translateY float32) {
+	scaleX = float32(math.Hypot(float64(m.A), float64(m.B)))
+	if scaleX != 0 {
+		scaleY = m.Determinant() / scaleX
+	} else {
+		// With the x axis collapsed the determinant is zero, so the y
+		// scale can only be recovered from the length of the y basis.
+		scaleY = float32(math.Hypot(float64(m.C), float64(m.D)))
+	}
+	rotationDegrees = float32(math.Atan2(float64(m.B), float64(m.A)) * 180.0 / math.Pi)
+	return scaleX, scaleY, rotationDegrees, m.E, m.F
+}
+
 // Quad represents a quadrilateral defined by four corners.
 type Quad struct {
 	UL, UR, LL, LR Point // Upper-left, upper-right, lower-left, lower-right
@@ -343,6 +574,46 @@ func (q Quad) Bounds() Rect {
 	return r
 }
 
+// String returns the quad's four corners.
+func (q Quad) String() string {
+	return fmt.Sprintf("Quad[UL%v UR%v LL%v LR%v]", q.UL, q.UR, q.LL, q.LR)
+}
+
+// Contains checks if a point lies inside the quad or on one of its edges.
+// The quad is assumed to be convex; either winding order is accepted.
+// A degenerate quad with zero area contains no points.
+func (q Quad) Contains(p Point) bool {
+	if q.Area() == 0 {
+		return false
+	}
+	corners := [4]Point{q.UL, q.UR, q.LR, q.LL}
+	var pos, neg bool
+	for i, a := range corners {
+		b := corners[(i+1)%4]
+		cross := (b.X-a.X)*(p.Y-a.Y) - (b.Y-a.Y)*(p.X-a.X)
+		if cross > 0 {
+			pos = true
+		} else if cross < 0 {
+			neg = true
+		}
+		if pos && neg {
+			return false
+		}
+	}
+	return true
+}
+
+// Area returns the area enclosed by the quad.
+func (q Quad) Area() float32 {
+	corners := [4]Point{q.UL, q.UR, q.LR, q.LL}
+	var sum float32
+	for i, a := range corners {
+		b := corners[(i+1)%4]
+		sum += a.X*b.Y - b.X*a.Y
+	}
+	return float32(math.Abs(float64(sum))) / 2
+}
+
 // Helper functions
 func min32(a, b float32) float32 {
 	if a < b {
@@ -358,3 +629,8 @@ func max32(a, b float32) float32 {
 	return b
 }
 
+func approxEqual32(a, b float32) bool {
+	const tolerance = 1e-5
+	return math.Abs(float64(a-b)) < tolerance
+}
+
@@ -62,6 +62,68 @@ func TestPoint(t *testing.T) {
 			t.Errorf("expected (15, 30), got (%f, %f)", result.X, result.Y)
 		}
 	})
+
+	t.Run("Dot", func(t *testing.T) {
+		if d := NewPoint(1, 2).Dot(NewPoint(3, 4)); d != 11 {
+			t.Errorf("expected 11, got %f", d)
+		}
+		if d := NewPoint(1, 0).Dot(NewPoint(0, 1)); d != 0 {
+			t.Errorf("expected perpendicular dot 0, got %f", d)
+		}
+	})
+
+	t.Run("Cross", func(t *testing.T) {
+		if c := NewPoint(1, 0).Cross(NewPoint(0, 1)); c != 1 {
+			t.Errorf("expected 1, got %f", c)
+		}
+		if c := NewPoint(0, 1).Cross(NewPoint(1, 0)); c != -1 {
+			t.Errorf("expected -1, got %f", c)
+		}
+		if c := NewPoint(2, 4).Cross(NewPoint(1, 2)); c != 0 {
+			t.Errorf("expected parallel cross 0, got %f", c)
+		}
+	})
+
+	t.Run("Length", func(t *testing.T) {
+		if l := NewPoint(3, 4).Length(); l != 5 {
+			t.Errorf("expected 5, got %f", l)
+		}
+	})
+
+	t.Run("Normalize", func(t *testing.T) {
+		n := NewPoint(3, 4).Normalize()
+		if math.Abs(float64(n.X-0.6)) > 0.0001 || math.Abs(float64(n.Y-0.8)) > 0.0001 {
+			t.Errorf("expected (0.6, 0.8), got (%f, %f)", n.X, n.Y)
+		}
+		zero := Origin.Normalize()
+		if zero.X != 0 || zero.Y != 0 {
+			t.Errorf("expected zero point, got (%f, %f)", zero.X, zero.Y)
+		}
+	})
+
+	t.Run("Lerp", func(t *testing.T) {
+		a := NewPoint(0, 10)
+		b := NewPoint(10, 30)
+		tests := []struct {
+			t    float32
+			want Point
+		}{
+			{0, a},
+			{1, b},
+			{0.5, NewPoint(5, 20)},
+		}
+		for _, tt := range tests {
+			if got := a.Lerp(b, tt.t); !got.Equals(tt.want) {
+				t.Errorf("t=%f: expected %v, got %v", tt.t, tt.want, got)
+			}
+		}
+	})
+
+	t.Run("String", func(t *testing.T) {
+		if got := NewPoint(1.5, -2).String(); got != "(1.5, -2)" {
+			t.Errorf("unexpected string %q", got)
+		}
+	})
 }
 
 func TestRect(t *testing.T) {
@@ -127,6 +189,157 @@ func TestRect(t *testing.T) {
 			t.Error("unexpected dimensions")
 		}
 	})
+
+	t.Run("Area", func(t *testing.T) {
+		tests := []struct {
+			name string
+			r    Rect
+			want float32
+		}{
+			{"Normal", NewRect(0, 0, 10, 20), 200},
+			{"Degenerate", NewRect(10, 10, 10, 10), 0},
+			{"Empty", RectEmpty, 0},
+			{"Infinite", RectInfinite, float32(math.Inf(1))},
+		}
+		for _, tt := range tests {
+			if got := tt.r.Area(); got != tt.want {
+				t.Errorf("%s: expected area %f, got %f", tt.name, tt.want, got)
+			}
+		}
+	})
+
+	t.Run("Center", func(t *testing.T) {
+		c := NewRect(0, 0, 612, 792).Center()
+		if c.X != 306 || c.Y != 396 {
+			t.Errorf("expected (306, 396), got (%f, %f)", c.X, c.Y)
+		}
+	})
+
+	t.Run("Inset", func(t *testing.T) {
+		tests := []struct {
+			name   string
+			dx, dy float32
+			want   Rect
+		}{
+			{"Shrink", 10, 20, NewRect(10, 20, 90, 80)},
+			{"Expand", -10, -5, NewRect(-10, -5, 110, 105)},
+			{"Zero", 0, 0, NewRect(0, 0, 100, 100)},
+		}
+		for _, tt := range tests {
+			if got := NewRect(0, 0, 100, 100).Inset(tt.dx, tt.dy); got != tt.want {
+				t.Errorf("%s: expected %v, got %v", tt.name, tt.want, got)
+			}
+		}
+	})
+
+	t.Run("ContainsRect", func(t *testing.T) {
+		outer := NewRect(0, 0, 100, 100)
+		tests := []struct {
+			name  string
+			outer Rect
+			inner Rect
+			want  bool
+		}{
+			{"Inside", outer, NewRect(10, 10, 90, 90), true},
+			{"Same", outer, outer, true},
+			{"Overlapping", outer, NewRect(50, 50, 150, 150), false},
+			{"Outside", outer, NewRect(200, 200, 300, 300), false},
+			{"EmptyInner", outer, RectEmpty, true},
+			{"EmptyOuter", RectEmpty, outer, false},
+			{"InfiniteOuter", RectInfinite, outer, true},
+			{"InfiniteInner", outer, RectInfinite, false},
+		}
+		for _, tt := range tests {
+			if got := tt.outer.ContainsRect(tt.inner); got != tt.want {
+				t.Errorf("%s: expected %v, got %v", tt.name, tt.want, got)
+			}
+		}
+	})
+
+	t.Run("Normalize", func(t *testing.T) {
+		tests := []struct {
+			name string
+			r    Rect
+			want Rect
+		}{
+			{"AlreadyNormal", NewRect(0, 0, 10, 20), NewRect(0, 0, 10, 20)},
+			{"InvertedX", NewRect(10, 0, 0, 20), NewRect(0, 0, 10, 20)},
+			{"InvertedBoth", NewRect(10, 20, 0, 0), NewRect(0, 0, 10, 20)},
+			{"Empty", RectEmpty, RectEmpty},
+			{"Infinite", RectInfinite, RectInfinite},
+		}
+		for _, tt := range tests {
+			got := tt.r.Normalize()
+			if got != tt.want {
+				t.Errorf("%s: expected %v, got %v", tt.name, tt.want, got)
+			}
+			if got != RectEmpty && (got.Width() < 0 || got.Height() < 0) {
+				t.Errorf("%s: expected non-negative size, got %fx%f", tt.name, got.Width(), got.Height())
+			}
+		}
+	})
+
+	t.Run("String", func(t *testing.T) {
+		if got := NewRect(0, 0, 612, 792).String(); got != "Rect(0,0 → 612,792)" {
+			t.Errorf("unexpected string %q", got)
+		}
+	})
+}
+
+func TestIRect(t *testing.T) {
+	t.Run("Contains", func(t *testing.T) {
+		r := NewIRect(0, 0, 10, 10)
+		if !r.Contains(0, 0) || !r.Contains(9, 9) {
+			t.Error("expected points inside")
+		}
+		if r.Contains(10, 5) || r.Contains(-1, 5) {
+			t.Error("expected points outside")
+		}
+	})
+
+	t.Run("Union", func(t *testing.T) {
+		tests := []struct {
+			name string
+			a, b IRect
+			want IRect
+		}{
+			{"Overlapping", NewIRect(0, 0, 50, 50), NewIRect(25, 25, 100, 100), NewIRect(0, 0, 100, 100)},
+			{"Disjoint", NewIRect(0, 0, 10, 10), NewIRect(20, 20, 30, 30), NewIRect(0, 0, 30, 30)},
+			{"EmptyLeft", NewIRect(5, 5, 5, 5), NewIRect(20, 20, 30, 30), NewIRect(20, 20, 30, 30)},
+			{"EmptyRight", NewIRect(0, 0, 10, 10), NewIRect(50, 50, 40, 40), NewIRect(0, 0, 10, 10)},
+		}
+		for _, tt := range tests {
+			if got := tt.a.Union(tt.b); got != tt.want {
+				t.Errorf("%s: expected %v, got %v", tt.name, tt.want, got)
+			}
+		}
+	})
+
+	t.Run("Intersect", func(t *testing.T) {
+		got := NewIRect(0, 0, 50, 50).Intersect(NewIRect(25, 25, 100, 100))
+		if got != NewIRect(25, 25, 50, 50) {
+			t.Errorf("unexpected intersect result %v", got)
+		}
+		if !NewIRect(0, 0, 10, 10).Intersect(NewIRect(20, 20, 30, 30)).IsEmpty() {
+			t.Error("expected disjoint intersection to be empty")
+		}
+	})
+
+	t.Run("Translate", func(t *testing.T) {
+		got := NewIRect(0, 0, 10, 20).Translate(5, -5)
+		if got != NewIRect(5, -5, 15, 15) {
+			t.Errorf("unexpected translate result %v", got)
+		}
+		if got.Width() != 10 || got.Height() != 20 {
+			t.Error("translate should preserve dimensions")
+		}
+	})
+
+	t.Run("String", func(t *testing.T) {
+		if got := NewIRect(-1, 0, 10, 20).String(); got != "IRect(-1,0 → 10,20)" {
+			t.Errorf("unexpected string %q", got)
+		}
+	})
 }
 
 func TestMatrix(t *testing.T) {
@@ -172,6 +385,120 @@ func TestMatrix(t *testing.T) {
 			t.Errorf("expected (20, 0), got (%f, %f)", p.X, p.Y)
 		}
 	})
+
+	t.Run("Determinant", func(t *testing.T) {
+		m := MatrixScale(2, 3)
+		if m.Determinant() != 6 {
+			t.Errorf("expected determinant 6, got %f", m.Determinant())
+		}
+	})
+
+	t.Run("Invert", func(t *testing.T) {
+		m := MatrixScale(2, 3).Concat(MatrixRotate(30)).Concat(MatrixTranslate(10, -20))
+		inv, ok := m.Invert()
+		if !ok {
+			t.Fatal("expected matrix to be invertible")
+		}
+		result := m.Concat(inv)
+		for i, v := range []float32{result.A - 1, result.B, result.C, result.D - 1, result.E, result.F} {
+			if math.Abs(float64(v)) > 0.0001 {
+				t.Errorf("component %d deviates from identity by %f", i, v)
+			}
+		}
+	})
+
+	t.Run("InvertSingular", func(t *testing.T) {
+		if _, ok := MatrixScale(0, 1).Invert(); ok {
+			t.Error("expected singular matrix to be non-invertible")
+		}
+	})
+
+	t.Run("Fit", func(t *testing.T) {
+		page := NewRect(0, 0, 612, 792)
+		box := NewRect(0, 0, 200, 200)
+		got := MatrixFit(page, box).TransformRect(page)
+		// Height is the limiting dimension, so the page fills the box vertically
+		// and is centered horizontally.
+		if math.Abs(float64(got.Height()-200)) > 0.001 {
+			t.Errorf("expected height 200, got %f", got.Height())
+		}
+		if math.Abs(float64(got.Center().X-100)) > 0.001 || math.Abs(float64(got.Y0)) > 0.001 {
+			t.Errorf("expected centered result, got %v", got)
+		}
+		if !box.Inset(-0.001, -0.001).ContainsRect(got) {
+			t.Errorf("expected %v to fit inside %v", got, box)
+		}
+	})
+
+	t.Run("FitStretch", func(t *testing.T) {
+		src := NewRect(10, 10, 110, 60)
+		dst := NewRect(0, 0, 300, 400)
+		got := MatrixFitStretch(src, dst).TransformRect(src)
+		if got != dst {
+			t.Errorf("expected %v, got %v", dst, got)
+		}
+	})
+
+	t.Run("FitEmpty", func(t *testing.T) {
+		if MatrixFit(RectEmpty, RectUnit) != Identity {
+			t.Error("expected identity for empty source")
+		}
+		if MatrixFitStretch(RectUnit, RectEmpty) != Identity {
+			t.Error("expected identity for empty destination")
+		}
+		for _, fit := range []func(src, dst Rect) Matrix{MatrixFit, MatrixFitStretch} {
+			if fit(RectInfinite, RectUnit) != Identity {
+				t.Error("expected identity for infinite source")
+			}
+			if fit(RectUnit, RectInfinite) != Identity {
+				t.Error("expected identity for infinite destination")
+			}
+		}
+	})
+
+	t.Run("IsIdentity", func(t *testing.T) {
+		if !Identity.IsIdentity() {
+			t.Error("expected identity")
+		}
+		if !MatrixRotate(360).IsIdentity() {
+			t.Error("expected full rotation to be identity")
+		}
+		if MatrixTranslate(1, 0).IsIdentity() {
+			t.Error("expected translation not to be identity")
+		}
+	})
+
+	t.Run("Decompose", func(t *testing.T) {
+		tests := []struct {
+			name                string
+			m                   Matrix
+			sx, sy, rot, tx, ty float32
+		}{
+			{"Identity", Identity, 1, 1, 0, 0, 0},
+			{"Scale", MatrixScale(2, 3), 2, 3, 0, 0, 0},
+			{"ZeroScaleX", MatrixScale(0, 3), 0, 3, 0, 0, 0},
+			{"Rotate", MatrixRotate(45), 1, 1, 45, 0, 0},
+			{"Translate", MatrixTranslate(10, 20), 1, 1, 0, 10, 20},
+			{"Combined", MatrixScale(2, 3).Concat(MatrixRotate(30)).Concat(MatrixTranslate(5, 7)), 2, 3, 30, 5, 7},
+		}
+		for _, tt := range tests {
+			sx, sy, rot, tx, ty := tt.m.Decompose()
+			got := []float32{sx, sy, rot, tx, ty}
+			want := []float32{tt.sx, tt.sy, tt.rot, tt.tx, tt.ty}
+			for i := range got {
+				if math.Abs(float64(got[i]-want[i])) > 0.001 {
+					t.Errorf("%s: expected %v, got %v", tt.name, want, got)
+					break
+				}
+			}
+		}
+	})
+
+	t.Run("String", func(t *testing.T) {
+		if got := NewMatrix(1, 0, 0, 1, 10.5, 20).String(); got != "Matrix[1 0 0 1 10.5 20]" {
+			t.Errorf("unexpected string %q", got)
+		}
+	})
 }
 
 func TestQuad(t *testing.T) {
@@ -208,5 +535,62 @@ func TestQuad(t *testing.T) {
 			t.Error("unexpected bounds")
 		}
 	})
+
+	t.Run("Contains", func(t *testing.T) {
+		axis := QuadFromRect(NewRect(0, 0, 100, 50))
+		// Shear maps (x, y) to (x + y, y), giving a parallelogram.
+		sheared := axis.Transform(MatrixShear(1, 0))
+		// Vertical flip reverses the winding order.
+		flipped := axis.Transform(MatrixScale(1, -1))
+		tests := []struct {
+			name string
+			q    Quad
+			p    Point
+			want bool
+		}{
+			{"AxisInside", axis, NewPoint(50, 25), true},
+			{"AxisCorner", axis, NewPoint(0, 0), true},
+			{"AxisEdge", axis, NewPoint(100, 25), true},
+			{"AxisOutside", axis, NewPoint(150, 25), false},
+			{"ShearedInside", sheared, NewPoint(140, 45), true},
+			{"ShearedOutsideSlant", sheared, NewPoint(10, 45), false},
+			{"ShearedOutsideRight", sheared, NewPoint(120, 5), false},
+			{"FlippedInside", flipped, NewPoint(50, -25), true},
+			{"FlippedOutside", flipped, NewPoint(50, 25), false},
+			{"PointQuadFar", NewQuad(NewPoint(5, 5), NewPoint(5, 5), NewPoint(5, 5), NewPoint(5, 5)), NewPoint(1000, -3), false},
+			{"PointQuadSame", NewQuad(NewPoint(5, 5), NewPoint(5, 5), NewPoint(5, 5), NewPoint(5, 5)), NewPoint(5, 5), false},
+			{"ZeroHeight", QuadFromRect(NewRect(0, 0, 10, 0)), NewPoint(500, 0), false},
+			{"ZeroHeightOnEdge", QuadFromRect(NewRect(0, 0, 10, 0)), NewPoint(5, 0), false},
+		}
+		for _, tt := range tests {
+			if got := tt.q.Contains(tt.p); got != tt.want {
+				t.Errorf("%s: expected %v, got %v", tt.name, tt.want, got)
+			}
+		}
+	})
+
+	t.Run("Area", func(t *testing.T) {
+		axis := QuadFromRect(NewRect(0, 0, 100, 50))
+		if axis.Area() != 5000 {
+			t.Errorf("expected area 5000, got %f", axis.Area())
+		}
+		// Shearing preserves area.
+		sheared := axis.Transform(MatrixShear(1, 0))
+		if sheared.Area() != 5000 {
+			t.Errorf("expected sheared area 5000, got %f", sheared.Area())
+		}
+		rotated := axis.Transform(MatrixRotate(30))
+		if math.Abs(float64(rotated.Area()-5000)) > 0.01 {
+			t.Errorf("expected rotated area 5000, got %f", rotated.Area())
+		}
+	})
+
+	t.Run("String", func(t *testing.T) {
+		got := QuadFromRect(NewRect(0, 0, 10, 20)).String()
+		want := "Quad[UL(0, 0) UR(10, 0) LL(0, 20) LR(10, 20)]"
+		if got != want {
+			t.Errorf("expected %q, got %q", want, got)
+		}
+	})
 }
 
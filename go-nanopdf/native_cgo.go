@@ -66,6 +66,19 @@ func bufferData(ptr uintptr) []byte {
 	return C.GoBytes(unsafe.Pointer(data), C.int(length))
 }
 
+func bufferDataRange(ptr uintptr, off, n int) []byte {
+	buf := (*C.nanopdf_buffer_t)(unsafe.Pointer(ptr))
+	length := int(C.nanopdf_buffer_len(buf))
+	if off < 0 || off >= length || n <= 0 {
+		return nil
+	}
+	if n > length-off {
+		n = length - off
+	}
+	data := unsafe.Pointer(C.nanopdf_buffer_data(buf))
+	return C.GoBytes(unsafe.Add(data, off), C.int(n))
+}
+
 func bufferAppend(ptr uintptr, data []byte) int {
 	if len(data) == 0 {
 		return 0
@@ -78,6 +91,18 @@ func bufferAppend(ptr uintptr, data []byte) int {
 	return int(err)
 }
 
+func bufferCap(ptr uintptr) int {
+	// The C API doesn't expose capacity, so report the only bytes
+	// known to be allocated
+	return bufferLen(ptr)
+}
+
+func bufferGrow(ptr uintptr, n int) int {
+	// The C API doesn't expose a reserve call; the native buffer
+	// grows itself on append
+	return 0
+}
+
 func bufferClear(ptr uintptr) {
 	// Create new empty buffer and swap
 	// Since the C API doesn't have clear, we work around it
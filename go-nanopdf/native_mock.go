@@ -87,6 +87,23 @@ func bufferData(ptr uintptr) []byte {
 	return result
 }
 
+func bufferDataRange(ptr uintptr, off, n int) []byte {
+	mockBuffersMu.RLock()
+	defer mockBuffersMu.RUnlock()
+
+	buf, ok := mockBuffers[ptr]
+	if !ok || off < 0 || off >= len(buf.data) || n <= 0 {
+		return nil
+	}
+	if n > len(buf.data)-off {
+		n = len(buf.data) - off
+	}
+
+	result := make([]byte, n)
+	copy(result, buf.data[off:off+n])
+	return result
+}
+
 func bufferAppend(ptr uintptr, data []byte) int {
 	mockBuffersMu.Lock()
 	defer mockBuffersMu.Unlock()
@@ -99,6 +116,33 @@ func bufferAppend(ptr uintptr, data []byte) int {
 	return 0 // Success
 }
 
+func bufferCap(ptr uintptr) int {
+	mockBuffersMu.RLock()
+	defer mockBuffersMu.RUnlock()
+
+	buf, ok := mockBuffers[ptr]
+	if !ok {
+		return 0
+	}
+	return cap(buf.data)
+}
+
+func bufferGrow(ptr uintptr, n int) int {
+	mockBuffersMu.Lock()
+	defer mockBuffersMu.Unlock()
+
+	buf, ok := mockBuffers[ptr]
+	if !ok {
+		return 1 // Error
+	}
+	if cap(buf.data)-len(buf.data) < n {
+		grown := make([]byte, len(buf.data), len(buf.data)+n)
+		copy(grown, buf.data)
+		buf.data = grown
+	}
+	return 0 // Success
+}
+
 func bufferClear(ptr uintptr) {
 	mockBuffersMu.Lock()
 	defer mockBuffersMu.Unlock()